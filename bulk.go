@@ -0,0 +1,136 @@
+package unrolledlist
+
+import (
+	"errors"
+	"iter"
+)
+
+// ErrCapacityMismatch is returned by Concat when the two lists being
+// joined don't share the same node capacity.
+var ErrCapacityMismatch = errors.New("unrolledlist: node capacities do not match")
+
+// appendChain appends vs across nodes starting at cur, which may
+// already hold elements, creating new nodes of the given capacity as
+// needed, and returns the node the last element ended up in. capacity
+// must be positive: a node with zero or negative capacity can never
+// make room for an element, which would otherwise leave this spinning
+// forever creating new, equally empty nodes instead of ever
+// consuming vs.
+func appendChain[T any](cur *UnrolledList[T], capacity int, vs []T) *UnrolledList[T] {
+	if capacity <= 0 {
+		panic("unrolledlist: node capacity must be positive")
+	}
+	for len(vs) > 0 {
+		if len(cur.elements) == capacity {
+			next := New[T](capacity)
+			next.prev = cur
+			cur.next = next
+			cur = next
+		}
+		n := capacity - len(cur.elements)
+		if n > len(vs) {
+			n = len(vs)
+		}
+		cur.elements = append(cur.elements, vs[:n]...)
+		vs = vs[n:]
+	}
+	return cur
+}
+
+// tail returns the last node in l's chain.
+func (l *UnrolledList[T]) tail() *UnrolledList[T] {
+	n := l
+	for n.next != nil {
+		n = n.next
+	}
+	return n
+}
+
+// locate finds the node holding global position i along with i's
+// local index within that node's elements. It returns ErrOutOfBound
+// if i >= l.Len().
+func (l *UnrolledList[T]) locate(i int) (*UnrolledList[T], int, error) {
+	n := l
+	for i >= len(n.elements) {
+		if n.next == nil {
+			return nil, 0, ErrOutOfBound
+		}
+		i -= len(n.elements)
+		n = n.next
+	}
+	return n, i, nil
+}
+
+// AppendSlice appends every element of vs to the end of l. Unlike
+// calling Append once per element, it fills the tail node's spare
+// capacity with a single append and then chains full nodes for
+// whatever is left over, so bulk ingestion costs one grow per node
+// instead of one per element.
+func (l *UnrolledList[T]) AppendSlice(vs []T) {
+	if len(vs) == 0 {
+		return
+	}
+	tail := l.tail()
+	appendChain(tail, cap(tail.elements), vs)
+}
+
+// InsertSlice inserts every element of vs at position i in l,
+// shifting whatever was at and after i to make room. If i >= l.Len(),
+// it returns ErrOutOfBound, so it does not insert at the end of the
+// list (nor into an empty list). Like AppendSlice, it works at node
+// granularity: the elements displaced by the insertion are moved once
+// into the new tail of the chain, not shifted one at a time.
+func (l *UnrolledList[T]) InsertSlice(i int, vs []T) error {
+	if len(vs) == 0 {
+		return nil
+	}
+	n, idx, err := l.locate(i)
+	if err != nil {
+		return err
+	}
+
+	displaced := append([]T(nil), n.elements[idx:]...)
+	n.elements = n.elements[:idx]
+	rest := n.next
+
+	capacity := cap(n.elements)
+	cur := appendChain(n, capacity, vs)
+	cur = appendChain(cur, capacity, displaced)
+
+	cur.next = rest
+	if rest != nil {
+		rest.prev = cur
+	}
+	return nil
+}
+
+// Extend appends every value produced by seq to the end of l.
+func (l *UnrolledList[T]) Extend(seq iter.Seq[T]) {
+	tail := l.tail()
+	capacity := cap(tail.elements)
+	cur := tail
+	seq(func(v T) bool {
+		cur = appendChain(cur, capacity, []T{v})
+		return true
+	})
+}
+
+// Concat splices other's node chain onto the end of l, rebalancing
+// only the join point rather than copying other's elements one by
+// one. Finding l's tail walks l's nodes; the splice itself is O(1)
+// whatever other's length. l and other must share the same node
+// capacity, or Concat returns ErrCapacityMismatch. After a successful
+// call, other must not be used again: its nodes now belong to l.
+func (l *UnrolledList[T]) Concat(other *UnrolledList[T]) error {
+	if other == nil {
+		return nil
+	}
+	tail := l.tail()
+	if cap(tail.elements) != cap(other.elements) {
+		return ErrCapacityMismatch
+	}
+	tail.next = other
+	other.prev = tail
+	tail.rebalance()
+	return nil
+}
@@ -0,0 +1,128 @@
+package unrolledlist
+
+import "testing"
+
+func valuesOf(t *testing.T, list *UnrolledList[int]) []int {
+	t.Helper()
+	var got []int
+	for n := list; n != nil; n = n.next {
+		got = append(got, n.elements...)
+	}
+	return got
+}
+
+func assertValues(t *testing.T, list *UnrolledList[int], want ...int) {
+	t.Helper()
+	got := valuesOf(t, list)
+	if len(got) != len(want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("got %v, want %v", got, want)
+			return
+		}
+	}
+}
+
+func TestAppendSliceFillsTailThenChains(t *testing.T) {
+	list := New[int](3)
+	list.Append(1)
+	list.AppendSlice([]int{2, 3, 4, 5, 6, 7})
+	assertValues(t, list, 1, 2, 3, 4, 5, 6, 7)
+}
+
+func TestAppendSliceEmpty(t *testing.T) {
+	list := newPopulatedList(3, 3)
+	list.AppendSlice(nil)
+	assertValues(t, list, 0, 1, 2)
+}
+
+func TestInsertSliceIntoMiddle(t *testing.T) {
+	list := newPopulatedList(3, 6)
+	if err := list.InsertSlice(3, []int{100, 101}); err != nil {
+		t.Fatalf("InsertSlice returned error: %v", err)
+	}
+	assertValues(t, list, 0, 1, 2, 100, 101, 3, 4, 5)
+}
+
+func TestInsertSliceOutOfBounds(t *testing.T) {
+	list := newPopulatedList(3, 3)
+	if err := list.InsertSlice(100, []int{1}); err != ErrOutOfBound {
+		t.Errorf("InsertSlice(100, ...) = %v, want ErrOutOfBound", err)
+	}
+}
+
+func TestExtend(t *testing.T) {
+	list := newPopulatedList(3, 3)
+	list.Extend(func(yield func(int) bool) {
+		for _, v := range []int{3, 4, 5} {
+			if !yield(v) {
+				return
+			}
+		}
+	})
+	assertValues(t, list, 0, 1, 2, 3, 4, 5)
+}
+
+func TestConcat(t *testing.T) {
+	a := newPopulatedList(3, 4)
+	b := New[int](3)
+	b.Append(100)
+	b.Append(101)
+
+	if err := a.Concat(b); err != nil {
+		t.Fatalf("Concat returned error: %v", err)
+	}
+	assertValues(t, a, 0, 1, 2, 3, 100, 101)
+}
+
+func TestConcatRepairsPrevAcrossMerge(t *testing.T) {
+	a := New[int](3)
+	a.Append(0)
+
+	otherHead := New[int](3)
+	otherHead.Append(10)
+	otherHead.Append(11)
+	otherTail := New[int](3)
+	otherTail.Append(20)
+	otherTail.Append(21)
+	otherTail.Append(22)
+	otherHead.next = otherTail
+	otherTail.prev = otherHead
+
+	if err := a.Concat(otherHead); err != nil {
+		t.Fatalf("Concat returned error: %v", err)
+	}
+	assertValues(t, a, 0, 10, 11, 20, 21, 22)
+
+	// a absorbed otherHead during rebalance, so whatever used to follow
+	// otherHead must now point back at a, not at the node that was
+	// merged away.
+	if a.next != otherTail {
+		t.Fatalf("a.next = %p, want otherTail (%p)", a.next, otherTail)
+	}
+	if otherTail.prev != a {
+		t.Errorf("otherTail.prev = %p, want a (%p)", otherTail.prev, a)
+	}
+}
+
+func TestAppendSliceZeroCapacityPanics(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Fatal("AppendSlice on a zero-capacity list should panic instead of spinning forever")
+		}
+	}()
+	New[int](0).AppendSlice([]int{1, 2, 3})
+}
+
+func TestConcatCapacityMismatch(t *testing.T) {
+	a := New[int](3)
+	a.Append(1)
+	b := New[int](4)
+	b.Append(2)
+
+	if err := a.Concat(b); err != ErrCapacityMismatch {
+		t.Errorf("Concat(mismatched capacity) = %v, want ErrCapacityMismatch", err)
+	}
+}
@@ -0,0 +1,120 @@
+package unrolledlist
+
+import "iter"
+
+// Iterator is a stateful, pull-style cursor over an UnrolledList.
+// Unlike Iter, it never spawns a goroutine: a caller that stops
+// calling Next partway through simply stops, and there is nothing
+// left running to clean up.
+type Iterator[T any] struct {
+	node  *UnrolledList[T]
+	index int
+	value T
+}
+
+// NewIterator returns an Iterator positioned before the first
+// element of l.
+func NewIterator[T any](l *UnrolledList[T]) *Iterator[T] {
+	return &Iterator[T]{node: l, index: -1}
+}
+
+// Next advances it to the next element and reports whether there was
+// one. Value is only valid after a call to Next that returned true.
+func (it *Iterator[T]) Next() bool {
+	for it.node != nil {
+		it.index++
+		if it.index < len(it.node.elements) {
+			it.value = it.node.elements[it.index]
+			return true
+		}
+		it.node, it.index = it.node.next, -1
+	}
+	return false
+}
+
+// Value returns the element Next last advanced to.
+func (it *Iterator[T]) Value() T {
+	return it.value
+}
+
+// Close releases it. It holds nothing that needs releasing today, but
+// exists so callers don't have to care whether a given Iterator does.
+func (it *Iterator[T]) Close() {
+	it.node = nil
+}
+
+// Skip advances it past the next n elements without yielding them,
+// jumping whole nodes at a time rather than stepping through them
+// one by one, and returns how many of the n elements it ran out of
+// list before it could skip.
+func (it *Iterator[T]) Skip(n int) int {
+	for n > 0 && it.node != nil {
+		available := len(it.node.elements) - (it.index + 1)
+		if available <= 0 {
+			it.node, it.index = it.node.next, -1
+			continue
+		}
+		if n < available {
+			it.index += n
+			return 0
+		}
+		n -= available
+		it.node, it.index = it.node.next, -1
+	}
+	return n
+}
+
+// All returns a Seq2 yielding the index and value of every element of
+// l, for use in a range-over-func loop:
+//
+//	for i, v := range l.All() {
+//		...
+//	}
+func (l UnrolledList[T]) All() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		i := 0
+		for n := &l; n != nil; n = n.next {
+			for _, v := range n.elements {
+				if !yield(i, v) {
+					return
+				}
+				i++
+			}
+		}
+	}
+}
+
+// Iter gives you an iterator for list l (which you can use with
+// range). Returns a channel that will have all the elements from l
+// sent to it by a goroutine. It is kept for source compatibility;
+// prefer All or Iterator in new code.
+//
+// An earlier version of this method tried to detect a `for range`
+// that breaks early and stop the goroutine via
+// runtime.SetFinalizer, closing a done channel once the returned
+// channel value became unreachable. That scheme was broken: the
+// finalizer target has no surviving reference once Iter returns
+// (copying the channel's value out to the caller and into the
+// goroutine doesn't keep the original variable's address reachable),
+// so under ordinary GC pressure the finalizer could fire - and
+// silently truncate the iteration - while a caller was still actively
+// and fully consuming the channel, which is worse than the leak it
+// was meant to fix. There is no way to reliably tell, from inside
+// this method, that the caller stopped ranging over a plain chan T
+// without the caller doing something explicit, so Iter goes back to
+// the simple version: it leaks its goroutine if you break out of the
+// loop early, exactly like the original implementation before
+// Iterator and All existed. Use those instead when early termination
+// matters; neither ever spawns a goroutine, so there's nothing to
+// leak.
+func (l UnrolledList[T]) Iter() chan T {
+	ch := make(chan T, 1)
+	go func() {
+		defer close(ch)
+		it := NewIterator(&l)
+		for it.Next() {
+			ch <- it.Value()
+		}
+	}()
+	return ch
+}
@@ -0,0 +1,144 @@
+package unrolledlist
+
+import (
+	"runtime"
+	"testing"
+	"time"
+)
+
+func TestIteratorWalksAllElements(t *testing.T) {
+	list := newPopulatedList(3, 10)
+	it := NewIterator(list)
+	wanted := 0
+	for it.Next() {
+		if it.Value() != wanted {
+			t.Errorf("Wanted %v, got %v.", wanted, it.Value())
+		}
+		wanted++
+	}
+	if wanted != 10 {
+		t.Errorf("Iterator was supposed to yield 10 times, yielded %d.", wanted)
+	}
+}
+
+func TestIteratorStopsEarlyWithoutExhausting(t *testing.T) {
+	list := newPopulatedList(3, 10)
+	it := NewIterator(list)
+	for i := 0; i < 3; i++ {
+		if !it.Next() {
+			t.Fatalf("Next() returned false too early at i=%d", i)
+		}
+	}
+	it.Close()
+	if it.Next() {
+		t.Errorf("Next() after Close() should return false")
+	}
+}
+
+func TestAllYieldsIndexAndValue(t *testing.T) {
+	list := newPopulatedList(3, 10)
+	wanted := 0
+	for i, v := range list.All() {
+		if i != wanted || v != wanted {
+			t.Errorf("got (%d, %d), want (%d, %d)", i, v, wanted, wanted)
+		}
+		wanted++
+	}
+	if wanted != 10 {
+		t.Errorf("All was supposed to yield 10 times, yielded %d.", wanted)
+	}
+}
+
+func TestAllStopsWhenYieldReturnsFalse(t *testing.T) {
+	list := newPopulatedList(3, 10)
+	seen := 0
+	for range list.All() {
+		seen++
+		if seen == 4 {
+			break
+		}
+	}
+	if seen != 4 {
+		t.Errorf("got %d iterations, want 4", seen)
+	}
+}
+
+func TestIterYieldsInOrder(t *testing.T) {
+	list := newPopulatedList(3, 10)
+	wanted := 0
+	for el := range list.Iter() {
+		if wanted != el {
+			t.Errorf("Wanted %v, got %v.", wanted, el)
+		}
+		wanted++
+	}
+	if wanted != 10 {
+		t.Errorf("Iter was supposed to yield 10 times, yielded %d.", wanted)
+	}
+}
+
+// TestIterSurvivesConcurrentGC regression-tests a version of Iter
+// that tried to detect an abandoned channel via runtime.SetFinalizer:
+// under GC pressure concurrent with a full, non-breaking range, the
+// finalizer could fire mid-iteration and truncate it. A full range
+// over Iter must always see every element regardless of how much GC
+// happens while it runs.
+func TestIterSurvivesConcurrentGC(t *testing.T) {
+	stop := make(chan struct{})
+	go func() {
+		for {
+			select {
+			case <-stop:
+				return
+			default:
+				runtime.GC()
+				time.Sleep(time.Millisecond)
+			}
+		}
+	}()
+	defer close(stop)
+
+	list := newPopulatedList(3, 1000)
+	wanted := 0
+	for el := range list.Iter() {
+		if wanted != el {
+			t.Fatalf("Wanted %v, got %v.", wanted, el)
+		}
+		wanted++
+	}
+	if wanted != 1000 {
+		t.Errorf("Iter was supposed to yield 1000 times, yielded %d.", wanted)
+	}
+}
+
+// TestIteratorDoesNotLeakOnEarlyBreak checks that Iterator, unlike
+// Iter, never spawns a goroutine in the first place, so stopping
+// partway through has nothing to leak.
+func TestIteratorDoesNotLeakOnEarlyBreak(t *testing.T) {
+	before := runtime.NumGoroutine()
+	list := newPopulatedList(3, 1000)
+	it := NewIterator(list)
+	for i := 0; i < 5; i++ {
+		it.Next()
+	}
+	it.Close()
+	if got := runtime.NumGoroutine(); got != before {
+		t.Errorf("NumGoroutine() = %d, want %d (Iterator should never spawn one)", got, before)
+	}
+}
+
+// TestAllDoesNotLeakOnEarlyBreak checks the same for All.
+func TestAllDoesNotLeakOnEarlyBreak(t *testing.T) {
+	before := runtime.NumGoroutine()
+	list := newPopulatedList(3, 1000)
+	seen := 0
+	for range list.All() {
+		seen++
+		if seen == 5 {
+			break
+		}
+	}
+	if got := runtime.NumGoroutine(); got != before {
+		t.Errorf("NumGoroutine() = %d, want %d (All should never spawn one)", got, before)
+	}
+}
@@ -0,0 +1,257 @@
+package unrolledlist
+
+// Element identifies a single value stored in a List, the way
+// container/list's Element identifies a value stored in a
+// container/list.List. An Element records a position (a node and an
+// index within it), not the value itself: an insertion or removal that
+// shifts values within its node, or moves them between nodes, can
+// leave it pointing at a different value. InsertBefore and InsertAfter
+// keep their mark pointing at the same value, and MoveToFront and
+// MoveToBack keep their argument valid.
+type Element[T any] struct {
+	node  *UnrolledList[T]
+	index int
+	list  *List[T]
+}
+
+// Value returns the value e points to.
+func (e *Element[T]) Value() T {
+	return e.node.elements[e.index]
+}
+
+// Next returns the element following e, or nil if e is the last
+// element of the list. It advances within the current node's
+// elements first, and only follows node.next once it runs off the
+// end of the slice.
+func (e *Element[T]) Next() *Element[T] {
+	if e.index+1 < len(e.node.elements) {
+		return &Element[T]{node: e.node, index: e.index + 1, list: e.list}
+	}
+	for n := e.node.next; n != nil; n = n.next {
+		if len(n.elements) > 0 {
+			return &Element[T]{node: n, index: 0, list: e.list}
+		}
+	}
+	return nil
+}
+
+// Prev returns the element preceding e, or nil if e is the first
+// element of the list. It walks backwards within the current node's
+// elements first, and only follows node.prev once it runs off the
+// start of the slice.
+func (e *Element[T]) Prev() *Element[T] {
+	if e.index > 0 {
+		return &Element[T]{node: e.node, index: e.index - 1, list: e.list}
+	}
+	for n := e.node.prev; n != nil; n = n.prev {
+		if l := len(n.elements); l > 0 {
+			return &Element[T]{node: n, index: l - 1, list: e.list}
+		}
+	}
+	return nil
+}
+
+// List is a doubly-linked unrolled list built on the same nodes as
+// UnrolledList, exposing a container/list-style cursor API. Because
+// it tracks its tail node directly, PushBack and Back are O(1)
+// instead of the O(n) that UnrolledList.Append pays to walk to the
+// end of the chain.
+type List[T any] struct {
+	front    *UnrolledList[T]
+	back     *UnrolledList[T]
+	length   int
+	capacity int
+}
+
+// NewList returns an empty List that will pack up to capacity
+// elements into each node.
+func NewList[T any](capacity int) *List[T] {
+	n := New[T](capacity)
+	return &List[T]{front: n, back: n, capacity: capacity}
+}
+
+// Len returns the number of elements in l.
+func (l *List[T]) Len() int {
+	return l.length
+}
+
+// Front returns the first element of l, or nil if l is empty.
+func (l *List[T]) Front() *Element[T] {
+	if l.length == 0 {
+		return nil
+	}
+	return &Element[T]{node: l.front, index: 0, list: l}
+}
+
+// Back returns the last element of l, or nil if l is empty.
+func (l *List[T]) Back() *Element[T] {
+	if l.length == 0 {
+		return nil
+	}
+	// Remove can empty the tail node without unlinking it, so step back
+	// to the last node that still holds an element.
+	n := l.back
+	for len(n.elements) == 0 && n.prev != nil {
+		n = n.prev
+	}
+	return &Element[T]{node: n, index: len(n.elements) - 1, list: l}
+}
+
+// pushBack inserts v at the back of l's node chain, growing it if the
+// tail node is full, and returns where v landed.
+func (l *List[T]) pushBack(v T) (*UnrolledList[T], int) {
+	if len(l.back.elements) == cap(l.back.elements) {
+		n := New[T](l.capacity)
+		n.prev = l.back
+		l.back.next = n
+		l.back = n
+	}
+	l.back.elements = append(l.back.elements, v)
+	l.length++
+	return l.back, len(l.back.elements) - 1
+}
+
+// pushFront inserts v at the front of l's node chain, growing it if
+// the head node is full, and returns where v landed.
+func (l *List[T]) pushFront(v T) (*UnrolledList[T], int) {
+	if len(l.front.elements) == cap(l.front.elements) {
+		n := New[T](l.capacity)
+		n.next = l.front
+		l.front.prev = n
+		l.front = n
+	}
+	l.front.elements = insert(l.front.elements, 0, v)
+	l.length++
+	return l.front, 0
+}
+
+// PushBack inserts v at the back of l and returns its Element.
+func (l *List[T]) PushBack(v T) *Element[T] {
+	node, index := l.pushBack(v)
+	return &Element[T]{node: node, index: index, list: l}
+}
+
+// PushFront inserts v at the front of l and returns its Element.
+func (l *List[T]) PushFront(v T) *Element[T] {
+	node, index := l.pushFront(v)
+	return &Element[T]{node: node, index: index, list: l}
+}
+
+// splitNode splits a full node n in two, the way growDividing does
+// for UnrolledList, but also threads the prev pointers and keeps
+// l.back correct when n was the tail.
+func (l *List[T]) splitNode(n *UnrolledList[T]) {
+	newNode := New[T](cap(n.elements))
+	half := len(n.elements) / 2
+	newNode.elements = append(newNode.elements, n.elements[half:]...)
+	n.elements = n.elements[:half]
+
+	newNode.prev = n
+	newNode.next = n.next
+	if n.next != nil {
+		n.next.prev = newNode
+	}
+	n.next = newNode
+	if l.back == n {
+		l.back = newNode
+	}
+}
+
+// insertAt inserts v at mark.index+offset within mark's node,
+// splitting the node first if it has no room left. Splitting can
+// relocate mark itself into the new node, so insertAt repoints
+// mark.node/mark.index at whatever happens to mark's value before
+// computing where v actually lands, and shifts mark.index again if v
+// ends up at or before mark's (possibly new) position in the same
+// node. This keeps mark valid and still pointing at the same value
+// no matter which side of a split it fell on.
+func (l *List[T]) insertAt(mark *Element[T], offset int, v T) *Element[T] {
+	n := mark.node
+	markIdx := mark.index
+	idx := markIdx + offset
+	target := n
+
+	if len(n.elements) == cap(n.elements) {
+		half := len(n.elements) / 2
+		l.splitNode(n)
+
+		if markIdx >= half {
+			mark.node = n.next
+			mark.index = markIdx - half
+		}
+		if idx > half {
+			idx -= half
+			target = n.next
+		}
+	}
+
+	target.elements = insert(target.elements, idx, v)
+	if mark.node == target && mark.index >= idx {
+		mark.index++
+	}
+	l.length++
+	return &Element[T]{node: target, index: idx, list: l}
+}
+
+// InsertBefore inserts v immediately before mark and returns its
+// Element.
+func (l *List[T]) InsertBefore(v T, mark *Element[T]) *Element[T] {
+	return l.insertAt(mark, 0, v)
+}
+
+// InsertAfter inserts v immediately after mark and returns its
+// Element.
+func (l *List[T]) InsertAfter(v T, mark *Element[T]) *Element[T] {
+	return l.insertAt(mark, 1, v)
+}
+
+// detach deletes the value at e's current position from l's node
+// chain and returns it, leaving e.list and e.node untouched so the
+// caller can decide what happens to e next: Remove invalidates it,
+// while MoveToFront/MoveToBack immediately repoint it at v's new
+// position instead.
+func (l *List[T]) detach(e *Element[T]) (value T) {
+	value, e.node.elements = sliceDelete(e.node.elements, e.index)
+	e.node.rebalance()
+	if e.node.next == nil {
+		l.back = e.node
+	}
+	l.length--
+	return value
+}
+
+// Remove deletes e from l and returns its value. ok is false if e
+// does not belong to l (or has already been removed). Once removed,
+// e is no longer valid.
+func (l *List[T]) Remove(e *Element[T]) (value T, ok bool) {
+	if e == nil || e.list != l {
+		var zero T
+		return zero, false
+	}
+	value = l.detach(e)
+	e.list = nil
+	e.node = nil
+	return value, true
+}
+
+// MoveToFront moves e, which must belong to l, to the front of l. As
+// in container/list, e continues to identify the same element in its
+// new position; it is not invalidated the way Remove's argument is.
+func (l *List[T]) MoveToFront(e *Element[T]) {
+	if e == nil || e.list != l {
+		return
+	}
+	v := l.detach(e)
+	e.node, e.index = l.pushFront(v)
+}
+
+// MoveToBack moves e, which must belong to l, to the back of l. As in
+// container/list, e continues to identify the same element in its new
+// position; it is not invalidated the way Remove's argument is.
+func (l *List[T]) MoveToBack(e *Element[T]) {
+	if e == nil || e.list != l {
+		return
+	}
+	v := l.detach(e)
+	e.node, e.index = l.pushBack(v)
+}
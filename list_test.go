@@ -0,0 +1,154 @@
+package unrolledlist
+
+import "testing"
+
+func collect[T any](l *List[T]) (values []T) {
+	for e := l.Front(); e != nil; e = e.Next() {
+		values = append(values, e.Value())
+	}
+	return
+}
+
+func TestListPushBackAndFront(t *testing.T) {
+	l := NewList[int](3)
+	l.PushBack(1)
+	l.PushBack(2)
+	l.PushFront(0)
+
+	if got := collect(l); len(got) != 3 || got[0] != 0 || got[1] != 1 || got[2] != 2 {
+		t.Errorf("got %v, want [0 1 2]", got)
+	}
+	if l.Len() != 3 {
+		t.Errorf("Len() = %d, want 3", l.Len())
+	}
+}
+
+func TestListPushBackAcrossNodes(t *testing.T) {
+	l := NewList[int](2)
+	for i := 0; i < 7; i++ {
+		l.PushBack(i)
+	}
+	want := []int{0, 1, 2, 3, 4, 5, 6}
+	if got := collect(l); len(got) != len(want) {
+		t.Fatalf("got %v, want %v", got, want)
+	} else {
+		for i := range want {
+			if got[i] != want[i] {
+				t.Errorf("got %v, want %v", got, want)
+				break
+			}
+		}
+	}
+	if back := l.Back(); back.Value() != 6 {
+		t.Errorf("Back().Value() = %v, want 6", back.Value())
+	}
+}
+
+func TestListInsertBeforeAndAfter(t *testing.T) {
+	l := NewList[int](2)
+	mid := l.PushBack(1)
+	l.InsertBefore(0, mid)
+	l.InsertAfter(2, mid)
+
+	want := []int{0, 1, 2}
+	if got := collect(l); len(got) != 3 || got[0] != 0 || got[1] != 1 || got[2] != 2 {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
+func TestInsertBeforeRelocatesMarkAcrossSplit(t *testing.T) {
+	l := NewList[int](2)
+	l.PushBack(0)
+	e := l.PushBack(1)
+
+	l.InsertBefore(99, e)
+
+	if v := e.Value(); v != 1 {
+		t.Fatalf("e.Value() = %v, want 1 (mark should still point at its original value after the split)", v)
+	}
+	want := []int{0, 99, 1}
+	if got := collect(l); len(got) != 3 || got[0] != 0 || got[1] != 99 || got[2] != 1 {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
+func TestElementNextPrevCrossNodeBoundary(t *testing.T) {
+	l := NewList[int](2)
+	for i := 0; i < 4; i++ {
+		l.PushBack(i)
+	}
+	e := l.Front()
+	for i := 0; i < 3; i++ {
+		e = e.Next()
+	}
+	if e.Value() != 3 {
+		t.Fatalf("Value() = %v, want 3", e.Value())
+	}
+	for i := 3; i >= 0; i-- {
+		if e.Value() != i {
+			t.Errorf("Value() = %v, want %v", e.Value(), i)
+		}
+		e = e.Prev()
+	}
+	if e != nil {
+		t.Errorf("Prev() past the front should be nil, got %v", e.Value())
+	}
+}
+
+func TestListRemove(t *testing.T) {
+	l := NewList[int](2)
+	var elems []*Element[int]
+	for i := 0; i < 6; i++ {
+		elems = append(elems, l.PushBack(i))
+	}
+
+	if v, ok := l.Remove(elems[2]); !ok || v != 2 {
+		t.Errorf("Remove(elems[2]) = %v, %v; want 2, true", v, ok)
+	}
+	if got := collect(l); len(got) != 5 {
+		t.Errorf("got %v, want 5 elements", got)
+	}
+	if l.Len() != 5 {
+		t.Errorf("Len() = %d, want 5", l.Len())
+	}
+
+	if _, ok := l.Remove(elems[2]); ok {
+		t.Errorf("removing an already-removed element should return ok=false")
+	}
+}
+
+func TestListMoveToFrontAndBack(t *testing.T) {
+	l := NewList[int](2)
+	var elems []*Element[int]
+	for i := 0; i < 4; i++ {
+		elems = append(elems, l.PushBack(i))
+	}
+
+	l.MoveToFront(elems[3])
+	if got := collect(l); got[0] != 3 {
+		t.Errorf("got %v, want 3 first", got)
+	}
+
+	l.MoveToBack(elems[0])
+	if got := collect(l); got[len(got)-1] != 0 {
+		t.Errorf("got %v, want 0 last", got)
+	}
+}
+
+func TestMoveToFrontKeepsElementValid(t *testing.T) {
+	l := NewList[int](2)
+	var elems []*Element[int]
+	for i := 0; i < 4; i++ {
+		elems = append(elems, l.PushBack(i))
+	}
+
+	e := elems[3]
+	l.MoveToFront(e)
+
+	if v := e.Value(); v != 3 {
+		t.Fatalf("e.Value() after MoveToFront = %v, want 3 (e should still be valid, pointing at its new position)", v)
+	}
+	if n := e.Next(); n == nil || n.Value() != 0 {
+		t.Errorf("e.Next() after MoveToFront = %v, want element holding 0", n)
+	}
+}
@@ -0,0 +1,103 @@
+package unrolledlist
+
+import "sync"
+
+// Locking wraps an UnrolledList behind a sync.RWMutex, so it can be
+// read and written from multiple goroutines without external
+// synchronization.
+type Locking[T any] struct {
+	mu   sync.RWMutex
+	list *UnrolledList[T]
+}
+
+// NewLocking returns an empty Locking that will pack capacity
+// elements into each node.
+func NewLocking[T any](capacity int) *Locking[T] {
+	return &Locking[T]{list: New[T](capacity)}
+}
+
+// Len returns the number of elements in l.
+func (l *Locking[T]) Len() int {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	return l.list.Len()
+}
+
+// Append makes value the last element of l.
+func (l *Locking[T]) Append(value T) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.list.Append(value)
+}
+
+// Get returns the element in the i-th position of l.
+func (l *Locking[T]) Get(i int) (value T, ok bool) {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	return l.list.Get(i)
+}
+
+// Insert inserts value at position i in l. If i >= l.Len(), returns
+// ErrOutOfBound.
+func (l *Locking[T]) Insert(i int, value T) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if i >= l.list.Len() {
+		return ErrOutOfBound
+	}
+	return l.list.Insert(i, value)
+}
+
+// Remove deletes and returns the i-th element of l.
+func (l *Locking[T]) Remove(i int) (value T, ok bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.list.Remove(i)
+}
+
+// Pop deletes and returns the first element of l.
+func (l *Locking[T]) Pop() (value T, ok bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.list.Pop()
+}
+
+// WithLock runs f with l's write lock held, letting callers perform
+// several mutations on the underlying UnrolledList as one atomic
+// operation.
+func (l *Locking[T]) WithLock(f func(*UnrolledList[T])) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	f(l.list)
+}
+
+// Snapshot returns a copy of l's elements, consistent as of the
+// instant it was taken, that callers can range over freely without
+// holding any lock.
+func (l *Locking[T]) Snapshot() []T {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	values := make([]T, 0, l.list.Len())
+	for n := l.list; n != nil; n = n.next {
+		values = append(values, n.elements...)
+	}
+	return values
+}
+
+// Range calls f for each element of l in order, holding the read
+// lock for the duration of the call. It stops early if f returns
+// false. Unlike Iter, Range never spawns a goroutine, so it composes
+// safely with the lock that guards l.
+func (l *Locking[T]) Range(f func(i int, v T) bool) {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	i := 0
+	for n := l.list; n != nil; n = n.next {
+		for _, v := range n.elements {
+			if !f(i, v) {
+				return
+			}
+			i++
+		}
+	}
+}
@@ -0,0 +1,83 @@
+package unrolledlist
+
+import (
+	"sync"
+	"testing"
+)
+
+func TestLockingAppendAndGet(t *testing.T) {
+	l := NewLocking[int](3)
+	for i := 0; i < 10; i++ {
+		l.Append(i)
+	}
+	if l.Len() != 10 {
+		t.Errorf("Len() = %d, want 10", l.Len())
+	}
+	if v, ok := l.Get(5); !ok || v != 5 {
+		t.Errorf("Get(5) = %v, %v; want 5, true", v, ok)
+	}
+}
+
+func TestLockingConcurrentAppend(t *testing.T) {
+	l := NewLocking[int](4)
+	var wg sync.WaitGroup
+	for g := 0; g < 10; g++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < 100; i++ {
+				l.Append(i)
+			}
+		}()
+	}
+	wg.Wait()
+	if l.Len() != 1000 {
+		t.Errorf("Len() = %d, want 1000", l.Len())
+	}
+}
+
+func TestLockingSnapshot(t *testing.T) {
+	l := NewLocking[int](3)
+	for i := 0; i < 7; i++ {
+		l.Append(i)
+	}
+	snap := l.Snapshot()
+	if len(snap) != 7 {
+		t.Fatalf("len(snap) = %d, want 7", len(snap))
+	}
+	for i, v := range snap {
+		if v != i {
+			t.Errorf("snap[%d] = %d, want %d", i, v, i)
+		}
+	}
+}
+
+func TestLockingRangeStopsEarly(t *testing.T) {
+	l := NewLocking[int](3)
+	for i := 0; i < 10; i++ {
+		l.Append(i)
+	}
+	var seen []int
+	l.Range(func(i, v int) bool {
+		seen = append(seen, v)
+		return v < 3
+	})
+	if len(seen) != 4 {
+		t.Errorf("Range visited %d elements, want 4 (0..3)", len(seen))
+	}
+}
+
+func TestLockingWithLock(t *testing.T) {
+	l := NewLocking[int](3)
+	l.WithLock(func(list *UnrolledList[int]) {
+		list.Append(1)
+		list.Append(2)
+		list.Insert(1, 10)
+	})
+	if v, _ := l.Get(0); v != 1 {
+		t.Errorf("Get(0) = %v, want 1", v)
+	}
+	if v, _ := l.Get(1); v != 10 {
+		t.Errorf("Get(1) = %v, want 10", v)
+	}
+}
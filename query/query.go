@@ -0,0 +1,222 @@
+// Package query provides LINQ-style lazy operations over an
+// unrolledlist.UnrolledList: Where, Select, Take, Skip, First, Any,
+// All, Count, Reduce, and ToSlice. Everything is built on top of
+// unrolledlist.Iterator, so a chain like
+//
+//	query.From(list).Where(pred).Take(10).ToSlice()
+//
+// walks only as many nodes as it needs to and never allocates the
+// intermediate slices a naive Where/Take implementation would.
+package query
+
+import "github.com/ryszard/unrolledlist"
+
+// Enumerable is a lazy sequence of values of type T. It does nothing
+// until a terminal operation such as ToSlice, Count, or First walks
+// it.
+type Enumerable[T any] struct {
+	next     func() (T, bool)
+	length   func() int    // non-nil when the remaining count is known without iterating
+	skipNode func(int) int // non-nil when skipping can jump whole unrolledlist nodes
+}
+
+// From returns an Enumerable over the elements of list.
+func From[T any](list *unrolledlist.UnrolledList[T]) Enumerable[T] {
+	it := unrolledlist.NewIterator(list)
+	return Enumerable[T]{
+		next: func() (T, bool) {
+			if it.Next() {
+				return it.Value(), true
+			}
+			var zero T
+			return zero, false
+		},
+		length:   list.Len,
+		skipNode: it.Skip,
+	}
+}
+
+// Where returns the elements of e for which pred returns true.
+func (e Enumerable[T]) Where(pred func(T) bool) Enumerable[T] {
+	return Enumerable[T]{next: func() (T, bool) {
+		for {
+			v, ok := e.next()
+			if !ok {
+				var zero T
+				return zero, false
+			}
+			if pred(v) {
+				return v, true
+			}
+		}
+	}}
+}
+
+// Select returns the result of applying f to every element of e. It
+// is a free function, rather than a method of Enumerable[T], because
+// it needs a second type parameter for the result type.
+func Select[T, U any](e Enumerable[T], f func(T) U) Enumerable[U] {
+	return Enumerable[U]{
+		next: func() (U, bool) {
+			v, ok := e.next()
+			if !ok {
+				var zero U
+				return zero, false
+			}
+			return f(v), true
+		},
+		length: e.length,
+	}
+}
+
+// Take returns at most the first n elements of e. A negative n is
+// treated as 0.
+func (e Enumerable[T]) Take(n int) Enumerable[T] {
+	if n < 0 {
+		n = 0
+	}
+	remaining := n
+	take := Enumerable[T]{next: func() (T, bool) {
+		if remaining <= 0 {
+			var zero T
+			return zero, false
+		}
+		v, ok := e.next()
+		if ok {
+			remaining--
+		}
+		return v, ok
+	}}
+	if e.length != nil {
+		take.length = func() int {
+			if l := e.length(); l < n {
+				return l
+			}
+			return n
+		}
+	}
+	return take
+}
+
+// Skip returns the elements of e after the first n. When e is backed
+// directly by an unrolledlist.Iterator, skipping jumps whole nodes at
+// a time instead of stepping through n elements one by one.
+func (e Enumerable[T]) Skip(n int) Enumerable[T] {
+	requested := n
+	if e.skipNode != nil {
+		n = e.skipNode(n)
+	}
+	skipped := false
+	skip := Enumerable[T]{next: func() (T, bool) {
+		if !skipped {
+			skipped = true
+			for ; n > 0; n-- {
+				if _, ok := e.next(); !ok {
+					break
+				}
+			}
+		}
+		return e.next()
+	}}
+	if e.length != nil {
+		skip.length = func() int {
+			if l := e.length() - requested; l > 0 {
+				return l
+			}
+			return 0
+		}
+	}
+	return skip
+}
+
+// First returns the first element of e for which pred returns true.
+// ok is false if no element matches.
+func (e Enumerable[T]) First(pred func(T) bool) (value T, ok bool) {
+	for {
+		v, ok := e.next()
+		if !ok {
+			var zero T
+			return zero, false
+		}
+		if pred(v) {
+			return v, true
+		}
+	}
+}
+
+// Any reports whether any element of e satisfies pred.
+func (e Enumerable[T]) Any(pred func(T) bool) bool {
+	_, ok := e.First(pred)
+	return ok
+}
+
+// All reports whether every element of e satisfies pred.
+func (e Enumerable[T]) All(pred func(T) bool) bool {
+	for {
+		v, ok := e.next()
+		if !ok {
+			return true
+		}
+		if !pred(v) {
+			return false
+		}
+	}
+}
+
+// Count returns the number of elements in e. If e has not been
+// filtered by Where, the count is already known (the container is
+// unrolled, so its length is a cheap field lookup, not a walk) and
+// Count returns it without iterating; filter first with Where to
+// count only matching elements.
+func (e Enumerable[T]) Count() int {
+	if e.length != nil {
+		return e.length()
+	}
+	count := 0
+	for {
+		if _, ok := e.next(); !ok {
+			return count
+		}
+		count++
+	}
+}
+
+// CountFunc returns the number of elements of e for which pred
+// returns true, following the slices.ContainsFunc/IndexFunc naming
+// convention for a predicate-taking variant of a method that would
+// otherwise collide with the argument-less Count. It is equivalent
+// to e.Where(pred).Count(), and like that combination always walks e
+// rather than taking Count's Len shortcut.
+func (e Enumerable[T]) CountFunc(pred func(T) bool) int {
+	return e.Where(pred).Count()
+}
+
+// Reduce folds e into a single value by calling f with the
+// accumulator and each element in turn, starting from initial. It is
+// a free function, rather than a method of Enumerable[T], because it
+// needs a second type parameter for the accumulator type.
+func Reduce[T, A any](e Enumerable[T], initial A, f func(A, T) A) A {
+	acc := initial
+	for {
+		v, ok := e.next()
+		if !ok {
+			return acc
+		}
+		acc = f(acc, v)
+	}
+}
+
+// ToSlice collects the remaining elements of e into a slice.
+func (e Enumerable[T]) ToSlice() []T {
+	var out []T
+	if e.length != nil {
+		out = make([]T, 0, e.length())
+	}
+	for {
+		v, ok := e.next()
+		if !ok {
+			return out
+		}
+		out = append(out, v)
+	}
+}
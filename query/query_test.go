@@ -0,0 +1,136 @@
+package query
+
+import (
+	"testing"
+
+	"github.com/ryszard/unrolledlist"
+)
+
+func populated(capacity, n int) *unrolledlist.UnrolledList[int] {
+	list := unrolledlist.New[int](capacity)
+	for i := 0; i < n; i++ {
+		list.Append(i)
+	}
+	return list
+}
+
+func even(v int) bool { return v%2 == 0 }
+
+func TestWhereAndToSlice(t *testing.T) {
+	got := From(populated(3, 10)).Where(even).ToSlice()
+	want := []int{0, 2, 4, 6, 8}
+	if len(got) != len(want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("got %v, want %v", got, want)
+			break
+		}
+	}
+}
+
+func TestSelect(t *testing.T) {
+	got := Select(From(populated(3, 5)), func(v int) string {
+		return string(rune('a' + v))
+	}).ToSlice()
+	want := []string{"a", "b", "c", "d", "e"}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("got %v, want %v", got, want)
+			break
+		}
+	}
+}
+
+func TestTakeStopsEarly(t *testing.T) {
+	got := From(populated(3, 100)).Take(4).ToSlice()
+	want := []int{0, 1, 2, 3}
+	if len(got) != 4 {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("got %v, want %v", got, want)
+			break
+		}
+	}
+}
+
+func TestTakeNegativeIsEmpty(t *testing.T) {
+	if got := From(populated(3, 10)).Take(-1).ToSlice(); len(got) != 0 {
+		t.Errorf("Take(-1).ToSlice() = %v, want empty", got)
+	}
+}
+
+func TestSkip(t *testing.T) {
+	got := From(populated(3, 10)).Skip(7).ToSlice()
+	want := []int{7, 8, 9}
+	if len(got) != len(want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("got %v, want %v", got, want)
+			break
+		}
+	}
+}
+
+func TestSkipThenTake(t *testing.T) {
+	got := From(populated(4, 20)).Skip(5).Take(3).ToSlice()
+	want := []int{5, 6, 7}
+	if len(got) != len(want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("got %v, want %v", got, want)
+			break
+		}
+	}
+}
+
+func TestFirstAndAnyAndAll(t *testing.T) {
+	e := From(populated(3, 10))
+	if v, ok := e.First(func(v int) bool { return v > 5 }); !ok || v != 6 {
+		t.Errorf("First(>5) = %v, %v; want 6, true", v, ok)
+	}
+	if !From(populated(3, 10)).Any(func(v int) bool { return v == 9 }) {
+		t.Error("Any(==9) = false, want true")
+	}
+	if From(populated(3, 10)).Any(func(v int) bool { return v == 99 }) {
+		t.Error("Any(==99) = true, want false")
+	}
+	if !From(populated(3, 10)).All(func(v int) bool { return v < 10 }) {
+		t.Error("All(<10) = false, want true")
+	}
+	if From(populated(3, 10)).All(even) {
+		t.Error("All(even) = true, want false")
+	}
+}
+
+func TestCountShortCircuitsToLen(t *testing.T) {
+	if n := From(populated(3, 37)).Count(); n != 37 {
+		t.Errorf("Count() = %d, want 37", n)
+	}
+}
+
+func TestCountAfterWhereIteratesAndFilters(t *testing.T) {
+	if n := From(populated(3, 10)).Where(even).Count(); n != 5 {
+		t.Errorf("Count() = %d, want 5", n)
+	}
+}
+
+func TestCountFunc(t *testing.T) {
+	if n := From(populated(3, 10)).CountFunc(even); n != 5 {
+		t.Errorf("CountFunc(even) = %d, want 5", n)
+	}
+}
+
+func TestReduce(t *testing.T) {
+	sum := Reduce(From(populated(3, 5)), 0, func(acc, v int) int { return acc + v })
+	if sum != 10 {
+		t.Errorf("Reduce(sum) = %d, want 10", sum)
+	}
+}
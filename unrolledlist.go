@@ -10,13 +10,30 @@ var (
 	ErrOutOfBound = errors.New("index out of range")
 )
 
-type UnrolledList struct {
-	next     *UnrolledList
-	elements []interface{}
+// UnrolledList is an unrolled linked list holding values of type T.
+// Each node keeps a slice of elements rather than a single value, so
+// neighbouring elements stay packed together in memory and the cost
+// of growing the list is amortized over many appends.
+//
+// There is no UnrolledList = UnrolledList[any] alias for the old
+// interface{}-based API: a type can't alias its own instantiation, so
+// the shim would need a distinct name (e.g. AnyList), which doesn't
+// actually save call sites from updating to the generic type anyway.
+// Callers on the old API need to migrate to UnrolledList[T] directly.
+//
+// prev is maintained by List, the doubly-linked cursor API built on
+// top of these same nodes, by the bulk operations in bulk.go, and by
+// rebalance when it merges two nodes. The methods below never read
+// it, and Append and Insert, which add nodes through grow, leave it
+// unset.
+type UnrolledList[T any] struct {
+	next     *UnrolledList[T]
+	prev     *UnrolledList[T]
+	elements []T
 }
 
 // Len Returns the length of l.
-func (l UnrolledList) Len() int {
+func (l UnrolledList[T]) Len() int {
 	if l.next == nil {
 		return len(l.elements)
 	}
@@ -24,7 +41,7 @@ func (l UnrolledList) Len() int {
 }
 
 // Append makes value the last element of l.
-func (l *UnrolledList) Append(value interface{}) {
+func (l *UnrolledList[T]) Append(value T) {
 	if l.next == nil && len(l.elements) < cap(l.elements) {
 		l.elements = append(l.elements, value)
 	} else {
@@ -38,44 +55,24 @@ func (l *UnrolledList) Append(value interface{}) {
 // grow adds a new node as the next of list l. The next pointer of the
 // new node will be pointing at whatever was the next pointer of l
 // pointing at.
-func (l *UnrolledList) grow() {
+func (l *UnrolledList[T]) grow() {
 	nextNext := l.next
-	l.next = New(cap(l.elements))
+	l.next = New[T](cap(l.elements))
 	l.next.next = nextNext
 }
 
-func (l UnrolledList) iterate(ch chan interface{}) {
-	for _, el := range l.elements {
-		ch <- el
-	}
-	if l.next == nil {
-		close(ch)
-	} else {
-		l.next.iterate(ch)
-	}
-}
-
-// Iter gives you an iterator for list l (which you can use with
-// range). Returns a channel that will have all the elements from l
-// passed to by a goroutine.
-func (l UnrolledList) Iter() chan interface{} {
-	ch := make(chan interface{}, 1)
-	go l.iterate(ch)
-	return ch
-}
-
 // growDividing adds a new node after l, and puts half of l's elements
 // into the new node.
-func (l *UnrolledList) growDividing() {
+func (l *UnrolledList[T]) growDividing() {
 	l.grow()
 	half := len(l.elements) / 2
 	l.next.elements = append(l.next.elements, l.elements[half:]...)
 	l.elements = l.elements[:half]
 }
 
-// Get returns the element in the i-th position in l and nil if i <
-// l.Len(). ok is true if a value from l was returned.
-func (l UnrolledList) Get(i int) (value interface{}, ok bool) {
+// Get returns the element in the i-th position in l. ok is true if a
+// value from l was returned.
+func (l UnrolledList[T]) Get(i int) (value T, ok bool) {
 	length := len(l.elements)
 	switch {
 	case i < length:
@@ -83,24 +80,25 @@ func (l UnrolledList) Get(i int) (value interface{}, ok bool) {
 	case l.next != nil:
 		return l.next.Get(i - length)
 	}
-	return nil, false
+	var zero T
+	return zero, false
 }
 
 // insert is a helper function that inserts value at the i-th position
-// in the slice sl. If 
-func insert(sl []interface{}, i int, value interface{}) []interface{} {
-	sl = append(sl[:i], append([]interface{}{value}, sl[i:]...)...)
+// in the slice sl.
+func insert[T any](sl []T, i int, value T) []T {
+	sl = append(sl[:i], append([]T{value}, sl[i:]...)...)
 	return sl
 }
 
-func sliceDelete(sl []interface{}, i int) (interface{}, []interface{}) {
+func sliceDelete[T any](sl []T, i int) (T, []T) {
 	el := sl[i]
 	return el, append(sl[:i], sl[i+1:]...)
 }
 
 // Insert inserts value at position i in the list l. If i >
 // l.Len(), return ErrOutOfBound.
-func (l *UnrolledList) Insert(i int, value interface{}) error {
+func (l *UnrolledList[T]) Insert(i int, value T) error {
 	switch {
 	case i >= len(l.elements):
 		if l.next == nil {
@@ -121,7 +119,12 @@ func (l *UnrolledList) Insert(i int, value interface{}) error {
 // move an element from the next ndoe to the current one. If it is
 // possible to fit all the elements in both the current and next node
 // in one node, do it.
-func (l *UnrolledList) rebalance() {
+//
+// Merging l.next into l drops a node out of the chain, so rebalance
+// also repoints whatever came after it (l.next.next, as it was before
+// the merge) back at l; without that fixup its prev would keep
+// pointing at the now-discarded node.
+func (l *UnrolledList[T]) rebalance() {
 	length := len(l.elements)
 	capacity := cap(l.elements)
 	switch {
@@ -130,33 +133,38 @@ func (l *UnrolledList) rebalance() {
 	case length+len(l.next.elements) <= capacity:
 		l.elements = append(l.elements, l.next.elements...)
 		l.next = l.next.next
+		if l.next != nil {
+			l.next.prev = l
+		}
 	case capacity/2 > length:
-		var toBeMoved interface{}
+		var toBeMoved T
 		toBeMoved, l.next.elements = sliceDelete(l.next.elements, 0)
 		l.elements = append(l.elements, toBeMoved)
 	}
 }
 
-// Delete and return the ith element of l. If i is out of bound,
-// returns nil.
-func (l *UnrolledList) Remove(i int) (popped interface{}) {
+// Remove deletes and returns the i-th element of l. ok is false if i
+// is out of bound, in which case popped is the zero value of T.
+func (l *UnrolledList[T]) Remove(i int) (popped T, ok bool) {
 	length := len(l.elements)
 	if i < length {
 		popped, l.elements = sliceDelete(l.elements, i)
 		l.rebalance()
+		return popped, true
 	} else if l.next != nil {
 		return l.next.Remove(i - length)
 	}
 	return
 }
 
-// Delete and return the first element of l.
-func (l *UnrolledList) Pop() (popped interface{}) {
+// Pop deletes and returns the first element of l. ok is false if l is
+// empty.
+func (l *UnrolledList[T]) Pop() (popped T, ok bool) {
 	return l.Remove(0)
 }
 
-// Return an empty Unrolled Linked List that will contain capacity
-// elements in one cell.
-func New(capacity int) *UnrolledList {
-	return &UnrolledList{nil, make([]interface{}, 0, capacity)}
+// New returns an empty Unrolled Linked List that will contain
+// capacity elements in one cell.
+func New[T any](capacity int) *UnrolledList[T] {
+	return &UnrolledList[T]{elements: make([]T, 0, capacity)}
 }
@@ -1,21 +1,21 @@
 package unrolledlist
 
 import (
-	"testing"
 	"fmt"
+	"testing"
 )
 
 // Helper methods that give you more introspection into the list.
 
 // nodeLength returns the length of list l in nodes.
-func (l UnrolledList) nodeLength() int {
+func (l UnrolledList[T]) nodeLength() int {
 	if l.next == nil {
 		return 1
 	}
 	return 1 + l.next.nodeLength()
 }
 
-func (l UnrolledList) repr() string {
+func (l UnrolledList[T]) repr() string {
 	if l.next == nil {
 		return fmt.Sprintf("%v", l.elements)
 	}
@@ -24,18 +24,18 @@ func (l UnrolledList) repr() string {
 
 // newPopulatedList creates a new UnrolledList and appends integers
 // from 0 to n-1 to it.
-func newPopulatedList(capacity, n int) (list *UnrolledList) {
-	list = New(capacity)
+func newPopulatedList(capacity, n int) (list *UnrolledList[int]) {
+	list = New[int](capacity)
 	for i := 0; i < n; i++ {
 		list.Append(i)
 	}
 	return
 }
 
-func ListLike(t *testing.T, list *UnrolledList, values ...int) {
+func ListLike(t *testing.T, list *UnrolledList[int], values ...int) {
 	wasError := false
 	for i, wanted := range values {
-		if value := list.Get(i); value != wanted {
+		if value, ok := list.Get(i); !ok || value != wanted {
 			wasError = true
 			t.Errorf("Wrong value for index %d: %v (should be %v).", i, value, wanted)
 		}
@@ -56,7 +56,7 @@ func TestMoreNodes(t *testing.T) {
 }
 
 func TestDowncaseInsert(t *testing.T) {
-	sl := make([]interface{}, 3, 3)
+	sl := make([]int, 3, 3)
 	sl[0], sl[1] = 0, 2
 	sl = insert(sl, 1, 1)
 	if sl[0] != 0 || sl[1] != 1 || sl[2] != 2 {
@@ -65,7 +65,7 @@ func TestDowncaseInsert(t *testing.T) {
 }
 
 func TestInsertOneNode(t *testing.T) {
-	list := New(5)
+	list := New[int](5)
 	list.Append(0)
 	list.Append(2)
 	list.Insert(1, 1)
@@ -86,7 +86,7 @@ func TestInsertIntoMiddleNode(t *testing.T) {
 }
 
 func TestInsertOutOfBounds(t *testing.T) {
-	list := New(3)
+	list := New[int](3)
 	if err := list.Insert(100, 1); err == nil {
 		t.Error("Out of bound insert didn't return an error.")
 	}
@@ -107,7 +107,7 @@ func TestIteration(t *testing.T) {
 }
 
 func TestSliceDelete(t *testing.T) {
-	arr := []interface{}{0, 1, 2}
+	arr := []int{0, 1, 2}
 	el, arr := sliceDelete(arr, 1)
 	if el != 1 {
 		t.Errorf("Got %v, expected 1 (%v)", el, arr)
@@ -122,7 +122,7 @@ func TestPopSimple(t *testing.T) {
 
 	nodeLength := list.nodeLength()
 
-	if el := list.Pop(1); el != 1 {
+	if el, ok := list.Remove(1); !ok || el != 1 {
 		t.Errorf("Got %v, expected 1.", el)
 	}
 	ListLike(t, list, 0, 2)
@@ -136,7 +136,7 @@ func TestPopNotInFirstNode(t *testing.T) {
 
 	nodeLength := list.nodeLength()
 
-	if el := list.Pop(5); el != 5 {
+	if el, ok := list.Remove(5); !ok || el != 5 {
 		t.Errorf("Got %v, expected 1", el)
 	}
 	ListLike(t, list, 0, 1, 2, 3, 4, 6, 7, 8, 9)
@@ -151,7 +151,7 @@ func TestPopNodeMoveElementsFromAdjacent(t *testing.T) {
 	// [0, 1, 2, 3], [4, 5, 6, 7]
 
 	for i := 0; i < 3; i++ {
-		list.Pop(0)
+		list.Remove(0)
 	}
 
 	if l1, l2 := len(list.elements), len(list.next.elements); l1 != 2 || l2 != 3 {
@@ -168,7 +168,7 @@ func TestPopNodeMoveElementsWithMerge(t *testing.T) {
 	}
 
 	for i := 0; i < 4; i++ {
-		list.Pop(0)
+		list.Remove(0)
 	}
 	if nl, l1, l2 := list.nodeLength(), len(list.elements), len(list.next.elements); nl != 2 || l1 != 4 || l2 != 4 {
 		t.Errorf("The layout of the elements is wrong. It should be ([4 5 6 7] [8 9 10 11]), (%v) found.", list.repr())
@@ -181,26 +181,26 @@ func TestPopNodeMoveElementsWithMerge(t *testing.T) {
 func TestOutOfBounds(t *testing.T) {
 	list := newPopulatedList(3, 10)
 
-	if el := list.Pop(100); el != nil {
-		t.Errorf("Out of bound element should be nil, not %v.", el)
+	if el, ok := list.Remove(100); ok {
+		t.Errorf("Out of bound element should not be ok, got %v.", el)
 	}
 
 	list = newPopulatedList(3, 1)
-	if el := list.Pop(1); el != nil {
-		t.Errorf("Out of bound element should be nil, not %v.", el)
+	if el, ok := list.Remove(1); ok {
+		t.Errorf("Out of bound element should not be ok, got %v.", el)
 	}
 
 }
 
 func TestLength(t *testing.T) {
-	list := New(3)
-	if l := list.Length(); l != 0 {
+	list := New[int](3)
+	if l := list.Len(); l != 0 {
 		t.Errorf("An empty list should have length 0, not %v.", l)
 	}
 	for i := 0; i < 10; i++ {
 		list.Append(i)
 	}
-	if l := list.Length(); l != 10 {
+	if l := list.Len(); l != 10 {
 		t.Errorf("Wrong length: %v instead of 10.", l)
 	}
 
@@ -208,8 +208,8 @@ func TestLength(t *testing.T) {
 
 func TestAppendReallyAppends(t *testing.T) {
 	list := newPopulatedList(5, 10)
-	list.Pop(0)
-	list.Pop(0)
+	list.Pop()
+	list.Pop()
 	if l := list.nodeLength(); l != 2 {
 		t.Errorf("Expected to see two nodes.Seen %d: %v", l, list.repr())
 	}